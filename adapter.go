@@ -0,0 +1,28 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:52
+ * @Description:
+ */
+
+package rcache
+
+// Adapter 定义了缓存后端必须实现的存储接口。
+// Cache 的所有读写方法最终都会委托给 Adapter，
+// 这样使用方可以在 BigCache、内存 map 或各种淘汰策略（LRU/LFU/ARC）之间自由切换，
+// 而无需修改调用 Set/Get 等方法的业务代码。
+type Adapter interface {
+	// Get 根据键获取值，键不存在时返回 ErrKeyNotFound。
+	Get(key string) ([]byte, error)
+	// Set 写入或覆盖键对应的值。
+	Set(key string, value []byte) error
+	// Delete 删除键对应的值，键不存在也应返回 nil。
+	Delete(key string) error
+	// Has 判断键是否存在。
+	Has(key string) bool
+	// Iterate 遍历缓存中的所有键值对，fn 返回 false 时提前终止遍历。
+	Iterate(fn func(key string, value []byte) bool)
+	// Close 释放适配器持有的资源。
+	Close() error
+}
@@ -0,0 +1,241 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:52
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"container/list"
+	"sync"
+)
+
+type arcEntry struct {
+	key   string
+	value []byte
+	inT2  bool // 标记条目当前位于 T2 还是 T1，避免每次访问都线性扫描链表
+}
+
+// ARCAdapter 实现了自适应替换缓存（Adaptive Replacement Cache）算法。
+// T1/T2 分别保存"最近访问一次"和"最近访问多次"的条目，B1/B2 是对应的幽灵（ghost）
+// 链表，只记录最近被淘汰的键。命中 B1 说明近期访问模式偏向新键，p 增大、T1 配额变大；
+// 命中 B2 说明近期访问模式偏向热点键，p 减小、T2 配额变大，从而让缓存自适应地在
+// "近期性"和"频率"之间调整淘汰策略。
+type ARCAdapter struct {
+	mu       sync.Mutex
+	capacity int
+	p        int // T1 的目标容量
+
+	t1, t2, b1, b2 *list.List
+	items          map[string]*list.Element // 仅包含 T1/T2 中的条目
+	ghosts         map[string]*list.Element // 仅包含 B1/B2 中的条目
+	ghostList      map[string]*list.List    // 记录某个键当前位于 B1 还是 B2
+}
+
+// NewARCAdapter 创建一个容量为 capacity 的 ARCAdapter，capacity <= 0 表示不限制容量。
+func NewARCAdapter(capacity int) *ARCAdapter {
+	return &ARCAdapter{
+		capacity:  capacity,
+		t1:        list.New(),
+		t2:        list.New(),
+		b1:        list.New(),
+		b2:        list.New(),
+		items:     make(map[string]*list.Element),
+		ghosts:    make(map[string]*list.Element),
+		ghostList: make(map[string]*list.List),
+	}
+}
+
+func (a *ARCAdapter) Get(key string) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if elem, ok := a.items[key]; ok {
+		entry := elem.Value.(*arcEntry)
+		// T1/T2 中任意一次命中都视为"再次访问"，移动到 T2 头部。
+		a.listFor(entry).Remove(elem)
+		entry.inT2 = true
+		a.items[key] = a.t2.PushFront(entry)
+		return entry.value, nil
+	}
+	return nil, ErrKeyNotFound
+}
+
+func (a *ARCAdapter) Set(key string, value []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if elem, ok := a.items[key]; ok {
+		entry := elem.Value.(*arcEntry)
+		entry.value = value
+		a.listFor(entry).Remove(elem)
+		entry.inT2 = true
+		a.items[key] = a.t2.PushFront(entry)
+		return nil
+	}
+
+	if a.capacity <= 0 {
+		// capacity <= 0 表示不限制容量，和 LRUAdapter/LFUAdapter 的约定一致：
+		// 不淘汰、不维护幽灵链表，直接作为新键写入 T1。
+		a.items[key] = a.t1.PushFront(&arcEntry{key: key, value: value})
+		return nil
+	}
+
+	if _, ok := a.ghosts[key]; ok {
+		a.hitGhost(key)
+		a.replace(key)
+		a.removeGhost(key)
+		a.items[key] = a.t2.PushFront(&arcEntry{key: key, value: value, inT2: true})
+		return nil
+	}
+
+	// 全新的键。
+	if a.t1.Len()+a.b1.Len() == a.capacity {
+		if a.t1.Len() < a.capacity {
+			a.popGhost(a.b1)
+			a.replace(key)
+		} else {
+			a.popLRU(a.t1, a.items)
+		}
+	} else if a.t1.Len()+a.b1.Len() < a.capacity && a.t1.Len()+a.t2.Len()+a.b1.Len()+a.b2.Len() >= a.capacity {
+		if a.t1.Len()+a.t2.Len()+a.b1.Len()+a.b2.Len() == 2*a.capacity {
+			a.popGhost(a.b2)
+		}
+		a.replace(key)
+	}
+
+	a.items[key] = a.t1.PushFront(&arcEntry{key: key, value: value})
+	return nil
+}
+
+func (a *ARCAdapter) Delete(key string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if elem, ok := a.items[key]; ok {
+		a.listFor(elem.Value.(*arcEntry)).Remove(elem)
+		delete(a.items, key)
+	}
+	a.removeGhost(key)
+	return nil
+}
+
+func (a *ARCAdapter) Has(key string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, ok := a.items[key]
+	return ok
+}
+
+func (a *ARCAdapter) Iterate(fn func(key string, value []byte) bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, ll := range []*list.List{a.t1, a.t2} {
+		for elem := ll.Front(); elem != nil; elem = elem.Next() {
+			entry := elem.Value.(*arcEntry)
+			if !fn(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+func (a *ARCAdapter) Close() error {
+	return nil
+}
+
+// listFor 根据 entry.inT2 直接返回其所在的链表（T1 或 T2），O(1)，调用方需持有锁。
+func (a *ARCAdapter) listFor(entry *arcEntry) *list.List {
+	if entry.inT2 {
+		return a.t2
+	}
+	return a.t1
+}
+
+// hitGhost 根据命中的幽灵链表调整自适应参数 p，调用方需持有锁。
+func (a *ARCAdapter) hitGhost(key string) {
+	if a.ghostList[key] == a.b1 {
+		delta := 1
+		if a.b1.Len() > 0 && a.b2.Len() > a.b1.Len() {
+			delta = a.b2.Len() / a.b1.Len()
+		}
+		a.p += delta
+		if a.p > a.capacity {
+			a.p = a.capacity
+		}
+	} else {
+		delta := 1
+		if a.b2.Len() > 0 && a.b1.Len() > a.b2.Len() {
+			delta = a.b1.Len() / a.b2.Len()
+		}
+		a.p -= delta
+		if a.p < 0 {
+			a.p = 0
+		}
+	}
+}
+
+// replace 按照自适应参数 p 从 T1 或 T2 淘汰一个条目到对应的幽灵链表，调用方需持有锁。
+func (a *ARCAdapter) replace(key string) {
+	if a.t1.Len() > 0 && (a.t1.Len() > a.p || (a.ghostList[key] == a.b2 && a.t1.Len() == a.p)) {
+		a.moveLRUToGhost(a.t1, a.b1)
+	} else if a.t2.Len() > 0 {
+		a.moveLRUToGhost(a.t2, a.b2)
+	} else if a.t1.Len() > 0 {
+		a.moveLRUToGhost(a.t1, a.b1)
+	}
+}
+
+// moveLRUToGhost 将 src 链表尾部的条目移动到 dst 幽灵链表，调用方需持有锁。
+func (a *ARCAdapter) moveLRUToGhost(src, dst *list.List) {
+	elem := src.Back()
+	if elem == nil {
+		return
+	}
+	entry := elem.Value.(*arcEntry)
+	src.Remove(elem)
+	delete(a.items, entry.key)
+
+	ghostElem := dst.PushFront(entry.key)
+	a.ghosts[entry.key] = ghostElem
+	a.ghostList[entry.key] = dst
+}
+
+// popLRU 淘汰 src 链表尾部的条目并彻底丢弃（不进入幽灵链表），调用方需持有锁。
+func (a *ARCAdapter) popLRU(src *list.List, items map[string]*list.Element) {
+	elem := src.Back()
+	if elem == nil {
+		return
+	}
+	entry := elem.Value.(*arcEntry)
+	src.Remove(elem)
+	delete(items, entry.key)
+}
+
+// popGhost 丢弃幽灵链表 ll 尾部最旧的记录，调用方需持有锁。
+func (a *ARCAdapter) popGhost(ll *list.List) {
+	elem := ll.Back()
+	if elem == nil {
+		return
+	}
+	key := elem.Value.(string)
+	ll.Remove(elem)
+	delete(a.ghosts, key)
+	delete(a.ghostList, key)
+}
+
+// removeGhost 从幽灵链表中移除指定键，调用方需持有锁。
+func (a *ARCAdapter) removeGhost(key string) {
+	elem, ok := a.ghosts[key]
+	if !ok {
+		return
+	}
+	a.ghostList[key].Remove(elem)
+	delete(a.ghosts, key)
+	delete(a.ghostList, key)
+}
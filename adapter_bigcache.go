@@ -0,0 +1,81 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:52
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"context"
+	"errors"
+
+	"github.com/allegro/bigcache/v3"
+)
+
+// BigCacheAdapter 是基于 github.com/allegro/bigcache/v3 的 Adapter 实现，
+// 也是 NewCache 默认使用的后端。
+type BigCacheAdapter struct {
+	cache *bigcache.BigCache
+}
+
+// NewBigCacheAdapter 使用给定的上下文和配置创建一个 BigCacheAdapter。
+func NewBigCacheAdapter(ctx context.Context, config bigcache.Config) (*BigCacheAdapter, error) {
+	cache, err := bigcache.New(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	return &BigCacheAdapter{cache: cache}, nil
+}
+
+func (a *BigCacheAdapter) Get(key string) ([]byte, error) {
+	value, err := a.cache.Get(key)
+	if err != nil {
+		if errors.Is(err, bigcache.ErrEntryNotFound) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, err
+	}
+	return value, nil
+}
+
+func (a *BigCacheAdapter) Set(key string, value []byte) error {
+	return a.cache.Set(key, value)
+}
+
+func (a *BigCacheAdapter) Delete(key string) error {
+	err := a.cache.Delete(key)
+	if err != nil && errors.Is(err, bigcache.ErrEntryNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (a *BigCacheAdapter) Has(key string) bool {
+	_, err := a.cache.Get(key)
+	return err == nil
+}
+
+func (a *BigCacheAdapter) Iterate(fn func(key string, value []byte) bool) {
+	iterator := a.cache.Iterator()
+	for iterator.SetNext() {
+		entry, err := iterator.Value()
+		if err != nil {
+			continue
+		}
+		if !fn(entry.Key(), entry.Value()) {
+			return
+		}
+	}
+}
+
+func (a *BigCacheAdapter) Close() error {
+	return a.cache.Close()
+}
+
+// BytesInUse 返回 bigcache 当前占用的字节数，供 Cache.Stats 读取。
+func (a *BigCacheAdapter) BytesInUse() int {
+	return a.cache.Capacity()
+}
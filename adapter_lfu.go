@@ -0,0 +1,157 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:52
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"container/list"
+	"sync"
+)
+
+type lfuEntry struct {
+	key   string
+	value []byte
+	freq  int
+}
+
+// LFUAdapter 是基于"每个频次一条链表 + minFreq 指针"实现的最少使用频率淘汰策略 Adapter，
+// Get/Set 命中已存在的键都会使其频次加一，淘汰时优先从 minFreq 对应的链表尾部移除，
+// 从而将淘汰操作的时间复杂度维持在 O(1)。
+type LFUAdapter struct {
+	mu       sync.Mutex
+	capacity int
+	minFreq  int
+	items    map[string]*list.Element
+	freqs    map[int]*list.List
+}
+
+// NewLFUAdapter 创建一个容量为 capacity 的 LFUAdapter，capacity <= 0 表示不限制容量。
+func NewLFUAdapter(capacity int) *LFUAdapter {
+	return &LFUAdapter{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		freqs:    make(map[int]*list.List),
+	}
+}
+
+func (a *LFUAdapter) Get(key string) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	elem, ok := a.items[key]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	entry := elem.Value.(*lfuEntry)
+	a.touch(elem, entry)
+	return entry.value, nil
+}
+
+func (a *LFUAdapter) Set(key string, value []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if elem, ok := a.items[key]; ok {
+		entry := elem.Value.(*lfuEntry)
+		entry.value = value
+		a.touch(elem, entry)
+		return nil
+	}
+
+	if a.capacity > 0 && len(a.items) >= a.capacity {
+		a.evict()
+	}
+
+	entry := &lfuEntry{key: key, value: value, freq: 1}
+	ll, ok := a.freqs[1]
+	if !ok {
+		ll = list.New()
+		a.freqs[1] = ll
+	}
+	a.items[key] = ll.PushFront(entry)
+	a.minFreq = 1
+	return nil
+}
+
+func (a *LFUAdapter) Delete(key string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	elem, ok := a.items[key]
+	if !ok {
+		return nil
+	}
+	entry := elem.Value.(*lfuEntry)
+	a.freqs[entry.freq].Remove(elem)
+	delete(a.items, key)
+	return nil
+}
+
+func (a *LFUAdapter) Has(key string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, ok := a.items[key]
+	return ok
+}
+
+func (a *LFUAdapter) Iterate(fn func(key string, value []byte) bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, ll := range a.freqs {
+		for elem := ll.Front(); elem != nil; elem = elem.Next() {
+			entry := elem.Value.(*lfuEntry)
+			if !fn(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+func (a *LFUAdapter) Close() error {
+	return nil
+}
+
+// touch 将 entry 从其当前频次链表移动到 freq+1 的链表，调用方需持有锁。
+func (a *LFUAdapter) touch(elem *list.Element, entry *lfuEntry) {
+	oldFreq := entry.freq
+	a.freqs[oldFreq].Remove(elem)
+	if a.freqs[oldFreq].Len() == 0 {
+		delete(a.freqs, oldFreq)
+		if a.minFreq == oldFreq {
+			a.minFreq++
+		}
+	}
+
+	entry.freq++
+	ll, ok := a.freqs[entry.freq]
+	if !ok {
+		ll = list.New()
+		a.freqs[entry.freq] = ll
+	}
+	a.items[entry.key] = ll.PushFront(entry)
+}
+
+// evict 淘汰 minFreq 链表尾部（同频次中最久未被访问）的条目，调用方需持有锁。
+func (a *LFUAdapter) evict() {
+	ll, ok := a.freqs[a.minFreq]
+	if !ok {
+		return
+	}
+	elem := ll.Back()
+	if elem == nil {
+		return
+	}
+	entry := elem.Value.(*lfuEntry)
+	ll.Remove(elem)
+	if ll.Len() == 0 {
+		delete(a.freqs, a.minFreq)
+	}
+	delete(a.items, entry.key)
+}
@@ -0,0 +1,113 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:52
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"container/list"
+	"sync"
+)
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+// LRUAdapter 是基于双向链表 + map 实现的最近最少使用淘汰策略 Adapter。
+// Get 命中的条目会被移动到链表头部，写入超出容量时淘汰链表尾部的条目。
+type LRUAdapter struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+// NewLRUAdapter 创建一个容量为 capacity 的 LRUAdapter，capacity <= 0 表示不限制容量。
+func NewLRUAdapter(capacity int) *LRUAdapter {
+	return &LRUAdapter{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (a *LRUAdapter) Get(key string) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	elem, ok := a.items[key]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	a.ll.MoveToFront(elem)
+	return elem.Value.(*lruEntry).value, nil
+}
+
+func (a *LRUAdapter) Set(key string, value []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if elem, ok := a.items[key]; ok {
+		elem.Value.(*lruEntry).value = value
+		a.ll.MoveToFront(elem)
+		return nil
+	}
+
+	elem := a.ll.PushFront(&lruEntry{key: key, value: value})
+	a.items[key] = elem
+
+	if a.capacity > 0 && a.ll.Len() > a.capacity {
+		a.evictOldest()
+	}
+	return nil
+}
+
+func (a *LRUAdapter) Delete(key string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if elem, ok := a.items[key]; ok {
+		a.ll.Remove(elem)
+		delete(a.items, key)
+	}
+	return nil
+}
+
+func (a *LRUAdapter) Has(key string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, ok := a.items[key]
+	return ok
+}
+
+func (a *LRUAdapter) Iterate(fn func(key string, value []byte) bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for elem := a.ll.Front(); elem != nil; elem = elem.Next() {
+		entry := elem.Value.(*lruEntry)
+		if !fn(entry.key, entry.value) {
+			return
+		}
+	}
+}
+
+func (a *LRUAdapter) Close() error {
+	return nil
+}
+
+// evictOldest 淘汰链表尾部（最久未被访问）的条目，调用方需持有锁。
+func (a *LRUAdapter) evictOldest() {
+	elem := a.ll.Back()
+	if elem == nil {
+		return
+	}
+	a.ll.Remove(elem)
+	delete(a.items, elem.Value.(*lruEntry).key)
+}
@@ -0,0 +1,72 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:52
+ * @Description:
+ */
+
+package rcache
+
+import "sync"
+
+// SimpleMapAdapter 是一个不做任何淘汰的内存 Adapter 实现，适合容量可控或测试场景。
+type SimpleMapAdapter struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+}
+
+// NewSimpleMapAdapter 创建一个基于 map 的 Adapter。
+func NewSimpleMapAdapter() *SimpleMapAdapter {
+	return &SimpleMapAdapter{data: make(map[string][]byte)}
+}
+
+func (a *SimpleMapAdapter) Get(key string) ([]byte, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	value, ok := a.data[key]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+func (a *SimpleMapAdapter) Set(key string, value []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.data[key] = value
+	return nil
+}
+
+func (a *SimpleMapAdapter) Delete(key string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.data, key)
+	return nil
+}
+
+func (a *SimpleMapAdapter) Has(key string) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	_, ok := a.data[key]
+	return ok
+}
+
+func (a *SimpleMapAdapter) Iterate(fn func(key string, value []byte) bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	for key, value := range a.data {
+		if !fn(key, value) {
+			return
+		}
+	}
+}
+
+func (a *SimpleMapAdapter) Close() error {
+	return nil
+}
@@ -0,0 +1,166 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 13:10
+ * @Description:
+ */
+
+package rcache
+
+import "testing"
+
+// TestAdapters_BasicOperations 对 SimpleMap/LRU/LFU/ARC 四个 Adapter 实现跑同一套基本语义用例，
+// 保证 Get/Set/Delete/Has 在这些淘汰策略下表现一致（Iterate 由 TestAdapters_Iterate 覆盖）。
+func TestAdapters_BasicOperations(t *testing.T) {
+	newAdapters := map[string]func() Adapter{
+		"SimpleMap": func() Adapter { return NewSimpleMapAdapter() },
+		"LRU":       func() Adapter { return NewLRUAdapter(0) },
+		"LFU":       func() Adapter { return NewLFUAdapter(0) },
+		"ARC":       func() Adapter { return NewARCAdapter(0) },
+	}
+
+	for name, newAdapter := range newAdapters {
+		t.Run(name, func(t *testing.T) {
+			a := newAdapter()
+			defer a.Close()
+
+			if _, err := a.Get("missing"); err != ErrKeyNotFound {
+				t.Errorf("Get missing = %v, want ErrKeyNotFound", err)
+			}
+			if a.Has("missing") {
+				t.Error("Has missing = true, want false")
+			}
+
+			if err := a.Set("k1", []byte("v1")); err != nil {
+				t.Fatalf("Set error: %v", err)
+			}
+			if !a.Has("k1") {
+				t.Error("Has k1 = false, want true")
+			}
+
+			got, err := a.Get("k1")
+			if err != nil {
+				t.Fatalf("Get error: %v", err)
+			}
+			if string(got) != "v1" {
+				t.Errorf("Get k1 = %q, want %q", got, "v1")
+			}
+
+			if err := a.Set("k1", []byte("v2")); err != nil {
+				t.Fatalf("overwrite Set error: %v", err)
+			}
+			if got, _ := a.Get("k1"); string(got) != "v2" {
+				t.Errorf("Get k1 after overwrite = %q, want %q", got, "v2")
+			}
+
+			if err := a.Delete("k1"); err != nil {
+				t.Fatalf("Delete error: %v", err)
+			}
+			if a.Has("k1") {
+				t.Error("Has k1 after Delete = true, want false")
+			}
+			if err := a.Delete("k1"); err != nil {
+				t.Errorf("Delete of already-deleted key should be nil, got %v", err)
+			}
+		})
+	}
+}
+
+// TestAdapters_Iterate 验证 Iterate 能遍历到所有已写入的键，且提前返回 false 时会停止遍历。
+func TestAdapters_Iterate(t *testing.T) {
+	newAdapters := map[string]func() Adapter{
+		"SimpleMap": func() Adapter { return NewSimpleMapAdapter() },
+		"LRU":       func() Adapter { return NewLRUAdapter(0) },
+		"LFU":       func() Adapter { return NewLFUAdapter(0) },
+		"ARC":       func() Adapter { return NewARCAdapter(0) },
+	}
+
+	for name, newAdapter := range newAdapters {
+		t.Run(name, func(t *testing.T) {
+			a := newAdapter()
+			defer a.Close()
+
+			want := map[string]string{"a": "1", "b": "2", "c": "3"}
+			for k, v := range want {
+				if err := a.Set(k, []byte(v)); err != nil {
+					t.Fatalf("Set error: %v", err)
+				}
+			}
+
+			got := make(map[string]string)
+			a.Iterate(func(key string, value []byte) bool {
+				got[key] = string(value)
+				return true
+			})
+			if len(got) != len(want) {
+				t.Fatalf("Iterate visited %d keys, want %d", len(got), len(want))
+			}
+			for k, v := range want {
+				if got[k] != v {
+					t.Errorf("Iterate key %q = %q, want %q", k, got[k], v)
+				}
+			}
+
+			seen := 0
+			a.Iterate(func(key string, value []byte) bool {
+				seen++
+				return false
+			})
+			if seen != 1 {
+				t.Errorf("Iterate should stop after first key when fn returns false, visited %d", seen)
+			}
+		})
+	}
+}
+
+// TestLRUAdapter_EvictsLeastRecentlyUsed 验证容量受限时淘汰的是最久未被访问的键。
+func TestLRUAdapter_EvictsLeastRecentlyUsed(t *testing.T) {
+	a := NewLRUAdapter(2)
+	defer a.Close()
+
+	_ = a.Set("k1", []byte("v1"))
+	_ = a.Set("k2", []byte("v2"))
+
+	// 访问 k1，使其变为最近使用，k2 成为最久未使用。
+	if _, err := a.Get("k1"); err != nil {
+		t.Fatalf("Get k1 error: %v", err)
+	}
+
+	_ = a.Set("k3", []byte("v3"))
+
+	if a.Has("k2") {
+		t.Error("k2 should have been evicted as least recently used")
+	}
+	if !a.Has("k1") {
+		t.Error("k1 should still be present")
+	}
+	if !a.Has("k3") {
+		t.Error("k3 should be present")
+	}
+}
+
+// TestLFUAdapter_EvictsLeastFrequentlyUsed 验证容量受限时淘汰的是访问频率最低的键。
+func TestLFUAdapter_EvictsLeastFrequentlyUsed(t *testing.T) {
+	a := NewLFUAdapter(2)
+	defer a.Close()
+
+	_ = a.Set("k1", []byte("v1"))
+	_ = a.Set("k2", []byte("v2"))
+
+	// 多次访问 k1，提高其访问频率，k2 保持最低频率。
+	for i := 0; i < 3; i++ {
+		if _, err := a.Get("k1"); err != nil {
+			t.Fatalf("Get k1 error: %v", err)
+		}
+	}
+
+	_ = a.Set("k3", []byte("v3"))
+
+	if a.Has("k2") {
+		t.Error("k2 should have been evicted as least frequently used")
+	}
+	if !a.Has("k1") {
+		t.Error("k1 should still be present")
+	}
+}
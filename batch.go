@@ -0,0 +1,135 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:57
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MGet/MSet/MSetEX/MDelete 只是对现有单键方法的逐个调用，省去调用方自己写循环的
+// 样板代码；它们不持有覆盖整批操作的锁，也不提供任何原子性或隔离性保证——其他
+// goroutine 的并发读写仍可能穿插在批次中间的任意一个键之间。
+//
+// BatchError 聚合批量操作中各个键各自的失败原因。它实现了 error 接口，因此
+// MGet/MSet/MSetEX/MDelete 可以保持和其他方法一致的 error 返回值，同时仍然让
+// 调用方能够按键查出具体是哪些键失败、以及各自的原因，而不是让整个批次因为
+// 一个键出错就整体失败。
+type BatchError map[string]error
+
+func (e BatchError) Error() string {
+	parts := make([]string, 0, len(e))
+	for key, err := range e {
+		parts = append(parts, fmt.Sprintf("%s: %v", key, err))
+	}
+	return fmt.Sprintf("batch operation failed for %d key(s): %s", len(e), strings.Join(parts, "; "))
+}
+
+// MGet 批量获取多个键的值。读取失败（包括未命中）的键不会出现在返回的 map 中，
+// 而是被收集进返回的 BatchError。
+func (c *Cache) MGet(keys []string) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(keys))
+	var failed BatchError
+
+	for _, key := range keys {
+		value, err := c.Get(key)
+		if err != nil {
+			if failed == nil {
+				failed = make(BatchError)
+			}
+			failed[key] = err
+			continue
+		}
+		result[key] = value
+	}
+
+	if failed != nil {
+		return result, failed
+	}
+	return result, nil
+}
+
+// MGetString 是 MGet 的字符串便捷版本。
+func (c *Cache) MGetString(keys []string) (map[string]string, error) {
+	values, err := c.MGet(keys)
+
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key] = string(value)
+	}
+	return result, err
+}
+
+// MSet 批量写入多个键值对。单个键写入失败不会中断其余键的写入，所有失败都会被
+// 收集进返回的 BatchError。
+func (c *Cache) MSet(items map[string][]byte) error {
+	var failed BatchError
+
+	for key, value := range items {
+		if err := c.Set(key, value); err != nil {
+			if failed == nil {
+				failed = make(BatchError)
+			}
+			failed[key] = err
+		}
+	}
+
+	if failed != nil {
+		return failed
+	}
+	return nil
+}
+
+// MSetString 是 MSet 的字符串便捷版本。
+func (c *Cache) MSetString(items map[string]string) error {
+	data := make(map[string][]byte, len(items))
+	for key, value := range items {
+		data[key] = []byte(value)
+	}
+	return c.MSet(data)
+}
+
+// MSetEX 批量写入多个键值对并统一设置过期时间，失败语义与 MSet 相同。
+func (c *Cache) MSetEX(items map[string][]byte, ttl time.Duration) error {
+	var failed BatchError
+
+	for key, value := range items {
+		if err := c.SetEX(key, value, ttl); err != nil {
+			if failed == nil {
+				failed = make(BatchError)
+			}
+			failed[key] = err
+		}
+	}
+
+	if failed != nil {
+		return failed
+	}
+	return nil
+}
+
+// MDelete 批量删除多个键，单个键删除失败不会中断其余键的删除。
+func (c *Cache) MDelete(keys []string) error {
+	var failed BatchError
+
+	for _, key := range keys {
+		if err := c.Delete(key); err != nil {
+			if failed == nil {
+				failed = make(BatchError)
+			}
+			failed[key] = err
+		}
+	}
+
+	if failed != nil {
+		return failed
+	}
+	return nil
+}
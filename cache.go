@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/allegro/bigcache/v3"
+	"golang.org/x/sync/singleflight"
 )
 
 var (
@@ -27,17 +28,27 @@ var (
 )
 
 type Cache struct {
-	cache  *bigcache.BigCache
-	mutex  sync.RWMutex
-	cancel context.CancelFunc
+	adapter         Adapter
+	mutex           sync.RWMutex
+	cancel          context.CancelFunc
+	sf              singleflight.Group
+	negativeTTL     time.Duration
+	ttl             *ttlIndex
+	negative        *negativeIndex
+	janitorInterval time.Duration
+	janitorStop     chan struct{}
+	closeOnce       sync.Once
+	stats           *statsState
 }
 
-type Option func(ctx *context.Context, config *bigcache.Config)
+// Option 用于配置 NewCache 创建的 BigCache 实例以及 Cache 本身。
+// ctx/config 影响底层 BigCache，cache 影响 Cache 的行为（例如穿透保护的 TTL）。
+type Option func(ctx *context.Context, config *bigcache.Config, cache *Cache)
 
 // WithContext 允许为 BigCache 实例设置自定义上下文。
 // 该上下文可用于控制缓存的生命周期，允许进行取消和超时管理。
 func WithContext(ctx context.Context) Option {
-	return func(c *context.Context, cfg *bigcache.Config) {
+	return func(c *context.Context, cfg *bigcache.Config, cache *Cache) {
 		*c = ctx
 	}
 }
@@ -46,7 +57,7 @@ func WithContext(ctx context.Context) Option {
 // 超过此时间后，条目将自动从缓存中删除。
 // 这有助于管理内存使用，并确保不会提供过时的数据。
 func WithLifeWindow(life time.Duration) Option {
-	return func(c *context.Context, cfg *bigcache.Config) {
+	return func(c *context.Context, cfg *bigcache.Config, cache *Cache) {
 		cfg.LifeWindow = life
 	}
 }
@@ -55,69 +66,101 @@ func WithLifeWindow(life time.Duration) Option {
 // 在此时间段内，过期的条目将从缓存中删除。
 // 这有助于保持最佳性能和内存使用，确保过期条目不会滞留在缓存中。
 func WithCleanWindow(clean time.Duration) Option {
-	return func(c *context.Context, cfg *bigcache.Config) {
+	return func(c *context.Context, cfg *bigcache.Config, cache *Cache) {
 		cfg.CleanWindow = clean
 	}
 }
 
 // NewCache 返回一个新的 Cache 实例。
-// 它使用提供的配置选项初始化一个新的 BigCache 实例。
+// 它使用提供的配置选项初始化一个新的 BigCache 实例，并以 BigCacheAdapter 作为默认后端。
 // 缓存将根据提供的分钟参数具有默认的生命周期。
 // 如果指定了任何选项，将应用于缓存配置。
 func NewCache(minute int64, opts ...Option) (*Cache, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	config := bigcache.DefaultConfig(time.Duration(minute) * time.Minute)
+	c := &Cache{cancel: cancel, negativeTTL: defaultNegativeTTL, stats: &statsState{}}
 
 	for _, opt := range opts {
-		opt(&ctx, &config)
+		opt(&ctx, &config, c)
 	}
 
-	cache, err := bigcache.New(ctx, config)
+	// bigcache 只能在构造时登记淘汰回调，因此在创建 BigCacheAdapter 之前就接上
+	// c.recordRemoval，使其自身依生命周期或容量触发的淘汰也能同步清理过期索引与统计。
+	config.OnRemoveWithReason = func(key string, entry []byte, reason bigcache.RemoveReason) {
+		c.recordRemoval(key, reason)
+	}
+
+	adapter, err := NewBigCacheAdapter(ctx, config)
 	if err != nil {
 		cancel()
 		return nil, err
 	}
+	c.adapter = adapter
+	c.initTTL()
+
+	return c, nil
+}
+
+// NewCacheWithAdapter 使用给定的 Adapter 创建一个 Cache 实例。
+// 这允许使用方替换底层存储与淘汰策略（例如 NewLRUAdapter、NewLFUAdapter、NewARCAdapter），
+// 而无需改动 Set/Get 等调用方代码。opts 中作用于 Cache 本身的选项（WithNegativeTTL、
+// WithJanitorInterval、WithStatsCollector、WithSlowLogThreshold、WithLogger）同样生效；
+// 仅作用于 bigcache.Config 的选项（WithContext、WithLifeWindow、WithCleanWindow）
+// 在非 BigCacheAdapter 场景下不会有任何效果。
+func NewCacheWithAdapter(adapter Adapter, opts ...Option) *Cache {
+	ctx := context.Background()
+	config := bigcache.Config{}
+	c := &Cache{adapter: adapter, negativeTTL: defaultNegativeTTL, stats: &statsState{}}
+
+	for _, opt := range opts {
+		opt(&ctx, &config, c)
+	}
+
+	c.initTTL()
+	return c
+}
 
-	return &Cache{cache: cache, cancel: cancel}, nil
+// initTTL 初始化过期时间索引、穿透保护索引并启动后台清理协程。
+func (c *Cache) initTTL() {
+	c.ttl = newTTLIndex()
+	c.negative = newNegativeIndex()
+	c.startJanitor()
 }
 
 // Get 根据提供的键从缓存中检索值。
 // 返回值为字节切片，如果键不存在或检索过程中出现其他问题，则返回错误。
 // 此方法是线程安全的。
 func (c *Cache) Get(key string) ([]byte, error) {
-	if c.cache == nil {
+	if c.adapter == nil {
 		return nil, ErrNilCache
 	}
 	if key == "" {
 		return nil, ErrKeyEmpty
 	}
 
-	value, err := c.cache.Get(key)
-	if err != nil {
-		if errors.Is(err, bigcache.ErrEntryNotFound) {
-			return nil, ErrKeyNotFound
-		}
-		return nil, err
+	if c.negative.isMarked(key) {
+		c.recordMiss(key)
+		return nil, ErrNotFound
 	}
 
-	// 尝试将值反序列化为包装结构（用于 SetEX 值）
-	wrapper := struct {
-		Value     []byte    `json:"value"`
-		ExpiresAt time.Time `json:"expires_at"`
-	}{}
-
-	if err := json.Unmarshal(value, &wrapper); err != nil {
-		// 如果反序列化失败，则返回常规值
-		return value, nil
+	if expiry, ok := c.ttl.expiresAt(key); ok && time.Now().After(expiry) {
+		c.ttl.delete(key)
+		_ = c.adapter.Delete(key)
+		c.recordExpiration()
+		c.recordMiss(key)
+		return nil, ErrKeyNotFound
 	}
 
-	// 检查值是否已过期
-	if time.Now().After(wrapper.ExpiresAt) {
-		c.cache.Delete(key)
-		return nil, ErrKeyNotFound
+	start := time.Now()
+	value, err := c.adapter.Get(key)
+	c.logIfSlow("Get", key, time.Since(start))
+	if err != nil {
+		c.recordMiss(key)
+		return nil, err
 	}
 
-	return wrapper.Value, nil
+	c.recordHit(key)
+	return value, nil
 }
 
 // GetString 根据提供的键从缓存中检索值并返回字符串。
@@ -132,7 +175,7 @@ func (c *Cache) GetString(key string) (string, error) {
 
 // GetValue 根据提供的键从缓存中检索值。
 func (c *Cache) GetValue(key string, value interface{}) error {
-	data, err := c.cache.Get(key)
+	data, err := c.Get(key)
 	if err != nil {
 		return err
 	}
@@ -153,7 +196,7 @@ func (c *Cache) GetValue(key string, value interface{}) error {
 // 如果键为空或在设置操作中出现任何问题，则返回错误。
 // 此方法是线程安全的。
 func (c *Cache) Set(key string, value []byte) error {
-	if c.cache == nil {
+	if c.adapter == nil {
 		return ErrNilCache
 	}
 	if key == "" {
@@ -163,12 +206,18 @@ func (c *Cache) Set(key string, value []byte) error {
 		return ErrValueEmpty
 	}
 
-	return c.cache.Set(key, value)
+	c.negative.clear(key)
+
+	start := time.Now()
+	err := c.adapter.Set(key, value)
+	c.recordSet(key, time.Since(start))
+	return err
 }
 
 // SetString 使用提供的键和值在缓存中设置一个字符串值。
 func (c *Cache) SetString(key, value string) error {
-	return c.cache.Set(key, []byte(value))
+	c.negative.clear(key)
+	return c.adapter.Set(key, []byte(value))
 }
 
 // SetValue 使用提供的键和值在缓存中设置一个值。
@@ -176,25 +225,29 @@ func (c *Cache) SetValue(key string, value interface{}) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
+	c.negative.clear(key)
+
 	switch v := value.(type) {
 	case string:
-		return c.cache.Set(key, []byte(v))
+		return c.adapter.Set(key, []byte(v))
 	case []byte:
-		return c.cache.Set(key, v)
+		return c.adapter.Set(key, v)
 	default:
 		data, err := json.Marshal(value)
 		if err != nil {
 			return fmt.Errorf("failed to marshal data: %v", err)
 		}
-		return c.cache.Set(key, data)
+		return c.adapter.Set(key, data)
 	}
 }
 
 // SetEX 使用过期时间在缓存中设置一个值。
 // 值将在指定的持续时间后从缓存中删除。
 // 如果键为空或在设置操作中出现任何问题，则返回错误。
+// 与 Set 相同，写入的字节与传入的 value 完全一致，过期时间单独维护在内存索引中，
+// 因此不会有额外的 JSON 序列化开销。
 func (c *Cache) SetEX(key string, value []byte, expiration time.Duration) error {
-	if c.cache == nil {
+	if c.adapter == nil {
 		return ErrNilCache
 	}
 	if key == "" {
@@ -204,21 +257,17 @@ func (c *Cache) SetEX(key string, value []byte, expiration time.Duration) error
 		return ErrValueEmpty
 	}
 
-	// 创建带时间戳的包装结构
-	wrapper := struct {
-		Value     []byte    `json:"value"`
-		ExpiresAt time.Time `json:"expires_at"`
-	}{
-		Value:     value,
-		ExpiresAt: time.Now().Add(expiration),
-	}
+	c.negative.clear(key)
 
-	data, err := json.Marshal(wrapper)
+	start := time.Now()
+	err := c.adapter.Set(key, value)
+	c.recordSet(key, time.Since(start))
 	if err != nil {
-		return fmt.Errorf("failed to marshal value: %w", err)
+		return err
 	}
 
-	return c.cache.Set(key, data)
+	c.ttl.set(key, time.Now().Add(expiration))
+	return nil
 }
 
 // SetEXString 使用过期时间在缓存中设置一个字符串值。
@@ -230,14 +279,17 @@ func (c *Cache) SetEXString(key, value string, expiration time.Duration) error {
 // Delete 根据提供的键从缓存中删除一个值。
 // 如果键为空或在删除过程中出现任何问题，则返回错误。
 func (c *Cache) Delete(key string) error {
-	if c.cache == nil {
+	if c.adapter == nil {
 		return ErrNilCache
 	}
 	if key == "" {
 		return ErrKeyEmpty
 	}
 
-	return c.cache.Delete(key)
+	c.ttl.delete(key)
+	c.negative.clear(key)
+	c.recordDelete()
+	return c.adapter.Delete(key)
 }
 
 // Has 检查缓存中是否存在某个键。
@@ -247,23 +299,46 @@ func (c *Cache) Has(key string) bool {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
-	_, err := c.cache.Get(key)
-	if err != nil {
-		if errors.Is(err, bigcache.ErrEntryNotFound) {
-			return false
-		}
+	if c.negative.isMarked(key) {
+		return false
+	}
+	if expiry, ok := c.ttl.expiresAt(key); ok && time.Now().After(expiry) {
 		return false
 	}
-	return true
+	return c.adapter.Has(key)
+}
+
+// Keys 返回当前缓存中所有键的快照，已过期但尚未被 janitor 清理的键不会包含在内。
+// 返回的切片不会随后续写入/删除而更新。
+func (c *Cache) Keys() []string {
+	if c.adapter == nil {
+		return nil
+	}
+
+	now := time.Now()
+	keys := make([]string, 0)
+	c.adapter.Iterate(func(key string, value []byte) bool {
+		if expiry, ok := c.ttl.expiresAt(key); ok && now.After(expiry) {
+			return true
+		}
+		keys = append(keys, key)
+		return true
+	})
+	return keys
 }
 
 // Close 关闭缓存并释放与之相关的任何资源。
-// 当缓存不再需要时，应调用此方法以确保正确清理。
+// 当缓存不再需要时，应调用此方法以确保正确清理。重复调用是安全的。
 func (c *Cache) Close() {
-	if c.cancel != nil {
-		c.cancel()
-	}
-	if c.cache != nil {
-		c.cache.Close()
-	}
+	c.closeOnce.Do(func() {
+		if c.janitorStop != nil {
+			close(c.janitorStop)
+		}
+		if c.cancel != nil {
+			c.cancel()
+		}
+		if c.adapter != nil {
+			c.adapter.Close()
+		}
+	})
 }
@@ -0,0 +1,58 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 13:20
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache_GetValue_RespectsExpiry(t *testing.T) {
+	c := NewCacheWithAdapter(NewSimpleMapAdapter())
+	defer c.Close()
+
+	key := "expiring_value"
+	if err := c.SetEX(key, []byte(`"v"`), -1*time.Second); err != nil {
+		t.Fatalf("SetEX error: %v", err)
+	}
+
+	var s string
+	if err := c.GetValue(key, &s); err != ErrKeyNotFound {
+		t.Errorf("GetValue on expired key = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestCache_Keys_ExcludesExpired(t *testing.T) {
+	c := NewCacheWithAdapter(NewSimpleMapAdapter())
+	defer c.Close()
+
+	if err := c.SetString("live", "v"); err != nil {
+		t.Fatalf("SetString error: %v", err)
+	}
+	if err := c.SetEX("expiring", []byte("v"), -1*time.Second); err != nil {
+		t.Fatalf("SetEX error: %v", err)
+	}
+
+	keys := c.Keys()
+	for _, key := range keys {
+		if key == "expiring" {
+			t.Error("Keys() should not include an already-expired key")
+		}
+	}
+
+	found := false
+	for _, key := range keys {
+		if key == "live" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Keys() should still include the live key")
+	}
+}
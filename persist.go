@@ -0,0 +1,107 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:57
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"encoding/gob"
+	"os"
+	"time"
+)
+
+// persistRecord 是落盘时的单条记录，Remaining 是保存那一刻距过期还剩余的时间，
+// 加载时会以加载时刻为基准重新计算出绝对过期时间，从而保证 TTL 能继续正确倒计时。
+type persistRecord struct {
+	Key       string
+	Value     []byte
+	HasTTL    bool
+	Remaining time.Duration
+}
+
+// SaveToFile 将当前所有存活（未过期）的条目序列化为二进制文件，写入到 path。
+// 每条记录包含键、原始字节以及剩余 TTL，供 LoadFromFile 恢复。
+func (c *Cache) SaveToFile(path string) error {
+	if c.adapter == nil {
+		return ErrNilCache
+	}
+
+	now := time.Now()
+	records := make([]persistRecord, 0)
+
+	c.adapter.Iterate(func(key string, value []byte) bool {
+		record := persistRecord{Key: key, Value: value}
+
+		if expiry, ok := c.ttl.expiresAt(key); ok {
+			if expiry.Before(now) {
+				// 已过期的条目不落盘。
+				return true
+			}
+			record.HasTTL = true
+			record.Remaining = expiry.Sub(now)
+		}
+
+		records = append(records, record)
+		return true
+	})
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return gob.NewEncoder(file).Encode(records)
+}
+
+// LoadFromFile 从 SaveToFile 写入的文件中恢复条目，并以加载时刻为基准重新计算过期时间。
+func (c *Cache) LoadFromFile(path string) error {
+	if c.adapter == nil {
+		return ErrNilCache
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var records []persistRecord
+	if err := gob.NewDecoder(file).Decode(&records); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, record := range records {
+		if err := c.adapter.Set(record.Key, record.Value); err != nil {
+			return err
+		}
+		if record.HasTTL {
+			c.ttl.set(record.Key, now.Add(record.Remaining))
+		}
+	}
+	return nil
+}
+
+// NewFrom 创建一个新的 Cache 并尝试从 path 恢复之前由 SaveToFile 保存的条目。
+// 如果 path 不存在，则返回一个干净的 Cache 而不报错，方便在首次启动时直接使用。
+func NewFrom(path string, minute int64, opts ...Option) (*Cache, error) {
+	c, err := NewCache(minute, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.LoadFromFile(path); err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		c.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,175 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:59
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/allegro/bigcache/v3"
+)
+
+// Stats 是 Cache 运行时累计指标的只读快照。Hits/Misses/Sets/Deletes/Expirations
+// 通过原子操作累计，可安全地并发获取；BytesInUse 是读取时从 adapter 现取的实时值。
+//
+// Evictions/BytesInUse 目前只有 BigCacheAdapter 会上报：LRU/LFU/ARC/SimpleMap 等
+// 其他 adapter 没有实现对应的淘汰通知或容量查询接口，这两个字段会始终为 0。
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Sets        uint64
+	Deletes     uint64
+	Evictions   uint64
+	Expirations uint64
+	BytesInUse  uint64
+}
+
+// StatsCollector 允许使用方把 Cache 的命中/未命中/淘汰/写入延迟接入 Prometheus、
+// OpenTelemetry 或结构化日志等外部系统，而无需让本模块依赖它们。
+// OnEviction 目前只在使用 BigCacheAdapter 时触发，见 Stats 的说明。
+type StatsCollector interface {
+	OnHit(key string)
+	OnMiss(key string)
+	OnEviction(key string)
+	OnSet(key string, latency time.Duration)
+}
+
+// Logger 是 WithSlowLogThreshold 使用的最小日志接口，标准库 *log.Logger 天然满足该接口，
+// 使用方也可以实现自己的日志适配。
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// statsState 保存 Stats 的底层计数器以及可选的 collector/slow log 配置。
+type statsState struct {
+	hits        uint64
+	misses      uint64
+	sets        uint64
+	deletes     uint64
+	evictions   uint64
+	expirations uint64
+
+	collector StatsCollector
+
+	slowLogThreshold time.Duration
+	logger           Logger
+}
+
+// WithStatsCollector 设置一个 StatsCollector，Cache 会在 Get/Set 以及条目淘汰时
+// 同步回调它，方便桥接到外部监控系统。
+func WithStatsCollector(collector StatsCollector) Option {
+	return func(ctx *context.Context, cfg *bigcache.Config, cache *Cache) {
+		cache.stats.collector = collector
+	}
+}
+
+// WithSlowLogThreshold 设置 Get/Set 调用耗时超过 threshold 时触发的慢日志阈值。
+// 未通过 WithLogger 指定 Logger 时，使用标准库的 log.Default()。
+func WithSlowLogThreshold(threshold time.Duration) Option {
+	return func(ctx *context.Context, cfg *bigcache.Config, cache *Cache) {
+		cache.stats.slowLogThreshold = threshold
+		if cache.stats.logger == nil {
+			cache.stats.logger = log.Default()
+		}
+	}
+}
+
+// WithLogger 设置慢日志使用的 Logger，需要和 WithSlowLogThreshold 搭配使用。
+func WithLogger(logger Logger) Option {
+	return func(ctx *context.Context, cfg *bigcache.Config, cache *Cache) {
+		cache.stats.logger = logger
+	}
+}
+
+// Stats 返回当前累计的统计信息快照。
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:        atomic.LoadUint64(&c.stats.hits),
+		Misses:      atomic.LoadUint64(&c.stats.misses),
+		Sets:        atomic.LoadUint64(&c.stats.sets),
+		Deletes:     atomic.LoadUint64(&c.stats.deletes),
+		Evictions:   atomic.LoadUint64(&c.stats.evictions),
+		Expirations: atomic.LoadUint64(&c.stats.expirations),
+		BytesInUse:  c.bytesInUse(),
+	}
+}
+
+// bytesInUse 从 adapter 现取当前占用的字节数；adapter 未实现该查询接口时返回 0。
+func (c *Cache) bytesInUse() uint64 {
+	sizer, ok := c.adapter.(interface{ BytesInUse() int })
+	if !ok {
+		return 0
+	}
+	if n := sizer.BytesInUse(); n > 0 {
+		return uint64(n)
+	}
+	return 0
+}
+
+// recordHit 记录一次缓存命中，并在配置了 collector 时回调通知。
+func (c *Cache) recordHit(key string) {
+	atomic.AddUint64(&c.stats.hits, 1)
+	if c.stats.collector != nil {
+		c.stats.collector.OnHit(key)
+	}
+}
+
+// recordMiss 记录一次缓存未命中，并在配置了 collector 时回调通知。
+func (c *Cache) recordMiss(key string) {
+	atomic.AddUint64(&c.stats.misses, 1)
+	if c.stats.collector != nil {
+		c.stats.collector.OnMiss(key)
+	}
+}
+
+// recordSet 记录一次写入及其耗时，超过慢日志阈值时打印日志，并在配置了 collector 时回调通知。
+func (c *Cache) recordSet(key string, latency time.Duration) {
+	atomic.AddUint64(&c.stats.sets, 1)
+	if c.stats.collector != nil {
+		c.stats.collector.OnSet(key, latency)
+	}
+	c.logIfSlow("Set", key, latency)
+}
+
+// recordDelete 记录一次删除。
+func (c *Cache) recordDelete() {
+	atomic.AddUint64(&c.stats.deletes, 1)
+}
+
+// recordExpiration 记录一次因 SetEX 设置的 TTL 到期而被清理的条目。
+func (c *Cache) recordExpiration() {
+	atomic.AddUint64(&c.stats.expirations, 1)
+}
+
+// logIfSlow 在调用耗时超过 slowLogThreshold 时打印一条慢日志。
+func (c *Cache) logIfSlow(op, key string, latency time.Duration) {
+	if c.stats.slowLogThreshold <= 0 || latency < c.stats.slowLogThreshold || c.stats.logger == nil {
+		return
+	}
+	c.stats.logger.Printf("rcache: slow %s for key %q took %s (threshold %s)", op, key, latency, c.stats.slowLogThreshold)
+}
+
+// recordRemoval 在 BigCacheAdapter 通过 OnRemoveWithReason 主动淘汰条目时被调用，
+// 同步清理过期索引并更新 Evictions/Expirations 统计，同时回调 collector。
+// LRU/LFU/ARC/SimpleMap 等其他 adapter 不会调用它，见 Stats 的说明。
+func (c *Cache) recordRemoval(key string, reason bigcache.RemoveReason) {
+	c.ttl.delete(key)
+
+	switch reason {
+	case bigcache.Expired:
+		atomic.AddUint64(&c.stats.expirations, 1)
+	case bigcache.NoSpace:
+		atomic.AddUint64(&c.stats.evictions, 1)
+		if c.stats.collector != nil {
+			c.stats.collector.OnEviction(key)
+		}
+	}
+}
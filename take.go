@@ -0,0 +1,174 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:54
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/allegro/bigcache/v3"
+)
+
+// ErrNotFound 由调用方传入的 loader 返回，表示数据源中也不存在该键。
+// Take/TakeEX 捕获到该错误时会在 negativeIndex 中标记该键，用于防止缓存穿透。
+var ErrNotFound = errors.New("data not found")
+
+// defaultNegativeTTL 是穿透保护标记的默认存活时间。
+const defaultNegativeTTL = 5 * time.Second
+
+// WithNegativeTTL 设置缓存穿透保护中标记的存活时间，默认 5 秒。
+func WithNegativeTTL(ttl time.Duration) Option {
+	return func(ctx *context.Context, cfg *bigcache.Config, cache *Cache) {
+		cache.negativeTTL = ttl
+	}
+}
+
+// negativeShard 是穿透保护索引的一个分片，拥有独立的互斥锁。
+type negativeShard struct {
+	mu    sync.Mutex
+	items map[string]time.Time
+}
+
+// negativeIndex 分片记录“已确认不存在”的键及其标记过期时间，供 Get/Has/GetValue
+// 等访问方法直接判断是否命中穿透保护，而不会向底层 adapter 写入任何占位数据，
+// 因此 Iterate/Keys 也不会把这些键暴露出去。
+type negativeIndex struct {
+	shards [ttlShardCount]*negativeShard
+}
+
+func newNegativeIndex() *negativeIndex {
+	idx := &negativeIndex{}
+	for i := range idx.shards {
+		idx.shards[i] = &negativeShard{items: make(map[string]time.Time)}
+	}
+	return idx
+}
+
+func (idx *negativeIndex) shardFor(key string) *negativeShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return idx.shards[h.Sum32()%ttlShardCount]
+}
+
+// mark 记录 key 在 ttl 时间内被视为不存在；ttl <= 0 时使用 defaultNegativeTTL。
+func (idx *negativeIndex) mark(key string, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = defaultNegativeTTL
+	}
+	shard := idx.shardFor(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	shard.items[key] = time.Now().Add(ttl)
+}
+
+// isMarked 判断 key 当前是否被标记为不存在；标记已过期时会被惰性清除。
+func (idx *negativeIndex) isMarked(key string) bool {
+	shard := idx.shardFor(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	expiry, ok := shard.items[key]
+	if !ok {
+		return false
+	}
+	if time.Now().After(expiry) {
+		delete(shard.items, key)
+		return false
+	}
+	return true
+}
+
+// clear 移除 key 的穿透保护标记，在该键被真正写入真实数据时调用。
+func (idx *negativeIndex) clear(key string) {
+	shard := idx.shardFor(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	delete(shard.items, key)
+}
+
+// Take 实现旁路缓存（cache-aside）模式：优先从缓存读取，未命中时调用 loader 回源并写回缓存。
+// 相同 key 的并发回源请求通过 singleflight 合并为一次，以缓解缓存击穿；
+// loader 返回 ErrNotFound 时会在 negativeIndex 中标记该键，以缓解缓存穿透——
+// 标记存活期间，Get/Has/GetValue 都会直接返回"不存在"而不会再次触发回源。
+func (c *Cache) Take(ctx context.Context, key string, loader func() ([]byte, error)) ([]byte, error) {
+	return c.TakeEX(ctx, key, 0, loader)
+}
+
+// TakeEX 与 Take 类似，但回源命中后以 ttl 写入缓存；ttl <= 0 时等价于永久写入（Set）。
+func (c *Cache) TakeEX(ctx context.Context, key string, ttl time.Duration, loader func() ([]byte, error)) ([]byte, error) {
+	if c.adapter == nil {
+		return nil, ErrNilCache
+	}
+	if key == "" {
+		return nil, ErrKeyEmpty
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if value, err := c.Get(key); err == nil {
+		return value, nil
+	} else if errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	v, err, _ := c.sf.Do(key, func() (interface{}, error) {
+		// 双重检查：等待 singleflight 期间，其他 goroutine 可能已经回填了缓存。
+		if value, err := c.Get(key); err == nil {
+			return value, nil
+		} else if errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+
+		value, err := loader()
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				c.negative.mark(key, c.negativeTTL)
+			}
+			return nil, err
+		}
+
+		if err := c.storeRaw(key, value, ttl); err != nil {
+			return nil, err
+		}
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]byte), nil
+}
+
+// TakeInto 与 TakeEX 类似，但 loader 返回任意类型的值，经 JSON 序列化后写入缓存，
+// 并在命中（或回源成功）后将结果反序列化到 dst 中。
+func (c *Cache) TakeInto(ctx context.Context, key string, ttl time.Duration, dst interface{}, loader func() (interface{}, error)) error {
+	value, err := c.TakeEX(ctx, key, ttl, func() ([]byte, error) {
+		v, err := loader()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(v)
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(value, dst)
+}
+
+// storeRaw 根据 ttl 选择 Set 或 SetEX 写入缓存。
+func (c *Cache) storeRaw(key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		return c.Set(key, value)
+	}
+	return c.SetEX(key, value, ttl)
+}
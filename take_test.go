@@ -0,0 +1,123 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 13:10
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCache_Take(t *testing.T) {
+	c := NewCacheWithAdapter(NewSimpleMapAdapter())
+	defer c.Close()
+
+	t.Run("loads on miss and caches the result", func(t *testing.T) {
+		var calls int32
+		loader := func() ([]byte, error) {
+			atomic.AddInt32(&calls, 1)
+			return []byte("loaded"), nil
+		}
+
+		got, err := c.Take(context.Background(), "take_key", loader)
+		if err != nil {
+			t.Fatalf("Take error: %v", err)
+		}
+		if string(got) != "loaded" {
+			t.Errorf("Take = %q, want %q", got, "loaded")
+		}
+
+		// 第二次调用应直接命中缓存，不再触发 loader。
+		got, err = c.Take(context.Background(), "take_key", loader)
+		if err != nil {
+			t.Fatalf("Take (cached) error: %v", err)
+		}
+		if string(got) != "loaded" {
+			t.Errorf("Take (cached) = %q, want %q", got, "loaded")
+		}
+		if atomic.LoadInt32(&calls) != 1 {
+			t.Errorf("loader called %d times, want 1", calls)
+		}
+	})
+}
+
+func TestCache_Take_NegativeCache(t *testing.T) {
+	c := NewCacheWithAdapter(NewSimpleMapAdapter(), WithNegativeTTL(50*time.Millisecond))
+	defer c.Close()
+
+	var calls int32
+	loader := func() ([]byte, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, ErrNotFound
+	}
+
+	if _, err := c.Take(context.Background(), "missing_key", loader); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Take = %v, want ErrNotFound", err)
+	}
+
+	// 标记存活期间，Get/Has/GetValue 都应视为不存在，且不会再次触发 loader。
+	if _, err := c.Get("missing_key"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after negative cache = %v, want ErrNotFound", err)
+	}
+	if c.Has("missing_key") {
+		t.Error("Has after negative cache = true, want false")
+	}
+	if err := c.GetValue("missing_key", new(string)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetValue after negative cache = %v, want ErrNotFound", err)
+	}
+
+	if _, err := c.Take(context.Background(), "missing_key", loader); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("second Take = %v, want ErrNotFound", err)
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("loader called %d times while negative cache is live, want 1", calls)
+	}
+
+	// 负缓存既不应出现在 Keys() 中，底层 adapter 也不应持有任何该键的数据。
+	for _, key := range c.Keys() {
+		if key == "missing_key" {
+			t.Error("negatively-cached key should not appear in Keys()")
+		}
+	}
+
+	// 标记过期后，loader 应再次被调用。
+	time.Sleep(100 * time.Millisecond)
+	if _, err := c.Take(context.Background(), "missing_key", loader); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Take after expiry = %v, want ErrNotFound", err)
+	}
+	if atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("loader called %d times after negative cache expiry, want 2", calls)
+	}
+}
+
+func TestCache_Take_NegativeCache_ClearedByRealWrite(t *testing.T) {
+	c := NewCacheWithAdapter(NewSimpleMapAdapter(), WithNegativeTTL(time.Minute))
+	defer c.Close()
+
+	if _, err := c.Take(context.Background(), "later_key", func() ([]byte, error) {
+		return nil, ErrNotFound
+	}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Take = %v, want ErrNotFound", err)
+	}
+
+	// 即使负缓存标记尚未过期，直接 Set 真实数据也应让该键重新变为可见。
+	if err := c.Set("later_key", []byte("real_value")); err != nil {
+		t.Fatalf("Set error: %v", err)
+	}
+
+	got, err := c.Get("later_key")
+	if err != nil {
+		t.Fatalf("Get after Set = %v, want nil error", err)
+	}
+	if string(got) != "real_value" {
+		t.Errorf("Get after Set = %q, want %q", got, "real_value")
+	}
+}
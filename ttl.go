@@ -0,0 +1,182 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:56
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"container/heap"
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/allegro/bigcache/v3"
+)
+
+// ttlShardCount 是过期索引的分片数量，用于降低高并发读写下的锁竞争。
+const ttlShardCount = 32
+
+// defaultJanitorInterval 是后台清理协程扫描过期键的默认间隔。
+const defaultJanitorInterval = time.Minute
+
+// ttlItem 记录一个键的过期时间，是 ttlHeap 中的一个节点。
+type ttlItem struct {
+	key    string
+	expiry time.Time
+	index  int
+}
+
+// ttlHeap 是按过期时间排序的最小堆，堆顶始终是最先过期的键。
+type ttlHeap []*ttlItem
+
+func (h ttlHeap) Len() int           { return len(h) }
+func (h ttlHeap) Less(i, j int) bool { return h[i].expiry.Before(h[j].expiry) }
+func (h ttlHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *ttlHeap) Push(x interface{}) {
+	item := x.(*ttlItem)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *ttlHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*h = old[:n-1]
+	return item
+}
+
+// ttlShard 是过期索引的一个分片，拥有独立的互斥锁。
+type ttlShard struct {
+	mu    sync.Mutex
+	items map[string]*ttlItem
+	heap  ttlHeap
+}
+
+// ttlIndex 是分片化的键过期时间索引：Get 用它判断条目是否已过期，
+// janitor 用它批量弹出已过期的键并从底层 adapter 中删除。
+type ttlIndex struct {
+	shards [ttlShardCount]*ttlShard
+}
+
+func newTTLIndex() *ttlIndex {
+	idx := &ttlIndex{}
+	for i := range idx.shards {
+		idx.shards[i] = &ttlShard{items: make(map[string]*ttlItem)}
+	}
+	return idx
+}
+
+func (idx *ttlIndex) shardFor(key string) *ttlShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return idx.shards[h.Sum32()%ttlShardCount]
+}
+
+// set 记录 key 将在 expiry 时刻过期，若 key 已有记录则更新。
+func (idx *ttlIndex) set(key string, expiry time.Time) {
+	shard := idx.shardFor(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	if item, ok := shard.items[key]; ok {
+		item.expiry = expiry
+		heap.Fix(&shard.heap, item.index)
+		return
+	}
+
+	item := &ttlItem{key: key, expiry: expiry}
+	heap.Push(&shard.heap, item)
+	shard.items[key] = item
+}
+
+// expiresAt 返回 key 的过期时间，ok 为 false 表示该键没有设置 TTL（永不过期）。
+func (idx *ttlIndex) expiresAt(key string) (time.Time, bool) {
+	shard := idx.shardFor(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	item, ok := shard.items[key]
+	if !ok {
+		return time.Time{}, false
+	}
+	return item.expiry, true
+}
+
+// delete 移除 key 的过期记录，在条目被删除或淘汰时调用。
+func (idx *ttlIndex) delete(key string) {
+	shard := idx.shardFor(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	item, ok := shard.items[key]
+	if !ok {
+		return
+	}
+	heap.Remove(&shard.heap, item.index)
+	delete(shard.items, key)
+}
+
+// popExpired 弹出所有在 now 之前已过期的键，供 janitor 批量清理。
+func (idx *ttlIndex) popExpired(now time.Time) []string {
+	var expired []string
+	for _, shard := range idx.shards {
+		shard.mu.Lock()
+		for shard.heap.Len() > 0 && shard.heap[0].expiry.Before(now) {
+			item := heap.Pop(&shard.heap).(*ttlItem)
+			delete(shard.items, item.key)
+			expired = append(expired, item.key)
+		}
+		shard.mu.Unlock()
+	}
+	return expired
+}
+
+// WithJanitorInterval 设置后台清理协程扫描过期键的间隔，默认 1 分钟。
+func WithJanitorInterval(interval time.Duration) Option {
+	return func(ctx *context.Context, cfg *bigcache.Config, cache *Cache) {
+		cache.janitorInterval = interval
+	}
+}
+
+// startJanitor 启动后台清理协程，定期从 ttl 索引中弹出过期键并从 adapter 中删除。
+func (c *Cache) startJanitor() {
+	if c.janitorInterval <= 0 {
+		c.janitorInterval = defaultJanitorInterval
+	}
+	c.janitorStop = make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(c.janitorInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.purgeExpired()
+			case <-c.janitorStop:
+				return
+			}
+		}
+	}()
+}
+
+// purgeExpired 清理所有已过期的键，由 janitor 周期性调用。
+func (c *Cache) purgeExpired() {
+	for _, key := range c.ttl.popExpired(time.Now()) {
+		_ = c.adapter.Delete(key)
+		c.recordExpiration()
+	}
+}
@@ -0,0 +1,105 @@
+/**
+ * @Author:      leafney
+ * @GitHub:      https://github.com/leafney
+ * @Project:     rose-cache
+ * @Date:        2026-07-26 12:54
+ * @Description:
+ */
+
+package rcache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// TypedCache 是 *Cache 之上的泛型包装，负责 JSON 序列化/反序列化，
+// 使调用方无需在 Get 之后手动 json.Unmarshal，也不必关心底层存储的是字节切片。
+// string 与 []byte 会被直接读写，其余类型统一走 JSON。
+type TypedCache[T any] struct {
+	cache *Cache
+}
+
+// NewTypedCache 基于现有的 *Cache 创建一个 TypedCache[T]。
+func NewTypedCache[T any](cache *Cache) *TypedCache[T] {
+	return &TypedCache[T]{cache: cache}
+}
+
+// Get 根据提供的键从缓存中检索值并反序列化为 T。
+func (t *TypedCache[T]) Get(key string) (T, error) {
+	var zero T
+	data, err := t.cache.Get(key)
+	if err != nil {
+		return zero, err
+	}
+	return decodeTyped[T](data)
+}
+
+// Set 使用提供的键和值在缓存中设置一个值。
+func (t *TypedCache[T]) Set(key string, v T) error {
+	data, err := encodeTyped(v)
+	if err != nil {
+		return err
+	}
+	return t.cache.Set(key, data)
+}
+
+// SetEX 使用过期时间在缓存中设置一个值。
+func (t *TypedCache[T]) SetEX(key string, v T, ttl time.Duration) error {
+	data, err := encodeTyped(v)
+	if err != nil {
+		return err
+	}
+	return t.cache.SetEX(key, data, ttl)
+}
+
+// Take 实现旁路缓存模式：未命中时调用 loader 回源并写回缓存，详见 Cache.Take。
+func (t *TypedCache[T]) Take(key string, loader func() (T, error)) (T, error) {
+	var zero T
+	data, err := t.cache.Take(context.Background(), key, func() ([]byte, error) {
+		v, err := loader()
+		if err != nil {
+			return nil, err
+		}
+		return encodeTyped(v)
+	})
+	if err != nil {
+		return zero, err
+	}
+	return decodeTyped[T](data)
+}
+
+// Keys 返回当前缓存中所有键的快照。
+func (t *TypedCache[T]) Keys() []string {
+	return t.cache.Keys()
+}
+
+// encodeTyped 将 v 编码为底层缓存存储的字节切片，string/[]byte 直接转换，其余类型走 JSON。
+func encodeTyped[T any](v T) ([]byte, error) {
+	switch val := any(v).(type) {
+	case string:
+		return []byte(val), nil
+	case []byte:
+		return val, nil
+	default:
+		return json.Marshal(v)
+	}
+}
+
+// decodeTyped 将缓存中的字节切片解码为 T，string/[]byte 直接转换，其余类型走 JSON。
+func decodeTyped[T any](data []byte) (T, error) {
+	var zero T
+	switch any(zero).(type) {
+	case string:
+		return any(string(data)).(T), nil
+	case []byte:
+		return any(append([]byte(nil), data...)).(T), nil
+	default:
+		var v T
+		if err := json.Unmarshal(data, &v); err != nil {
+			return zero, err
+		}
+		return v, nil
+	}
+}